@@ -144,3 +144,37 @@ func (m matchCondition) FailureMessage(actual interface{}) (message string) {
 func (m matchCondition) NegatedFailureMessage(actual interface{}) (message string) {
 	return fmt.Sprintf("expected\n\t%#v\nto not match\n\t%#v\n", actual, m.expected)
 }
+
+// HaveConditionTrue returns a custom matcher to check that an object implementing the Getter interface
+// has a condition with the given type and status True.
+func HaveConditionTrue(conditionType string) types.GomegaMatcher {
+	return &haveConditionTrue{
+		conditionType: conditionType,
+	}
+}
+
+type haveConditionTrue struct {
+	conditionType string
+}
+
+func (m haveConditionTrue) Match(actual interface{}) (success bool, err error) {
+	getter, ok := actual.(Getter)
+	if !ok {
+		return false, fmt.Errorf("actual should implement the conditions.Getter interface")
+	}
+
+	return IsTrue(getter, m.conditionType), nil
+}
+
+func (m haveConditionTrue) FailureMessage(actual interface{}) (message string) {
+	if getter, ok := actual.(Getter); ok {
+		if c := Get(getter, m.conditionType); c != nil {
+			return fmt.Sprintf("expected condition %s to have status %s, got status %s", m.conditionType, metav1.ConditionTrue, c.Status)
+		}
+	}
+	return fmt.Sprintf("expected condition %s to have status %s, but the condition does not exist", m.conditionType, metav1.ConditionTrue)
+}
+
+func (m haveConditionTrue) NegatedFailureMessage(_ interface{}) (message string) {
+	return fmt.Sprintf("expected condition %s to not have status %s", m.conditionType, metav1.ConditionTrue)
+}
@@ -325,3 +325,40 @@ func TestMatchCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestHaveConditionTrue(t *testing.T) {
+	obj := objectWithValueGetter{
+		Status: objectWithValueGetterStatus{
+			Conditions: []metav1.Condition{
+				{Type: "trueCondition", Status: metav1.ConditionTrue},
+				{Type: "falseCondition", Status: metav1.ConditionFalse},
+			},
+		},
+	}
+
+	t.Run("with a True condition", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(obj).To(HaveConditionTrue("trueCondition"))
+	})
+
+	t.Run("with a False condition", func(t *testing.T) {
+		g := NewWithT(t)
+
+		m := HaveConditionTrue("falseCondition")
+		ok, err := m.Match(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ok).To(BeFalse())
+		g.Expect(m.FailureMessage(obj)).To(ContainSubstring("got status False"))
+	})
+
+	t.Run("with a missing condition", func(t *testing.T) {
+		g := NewWithT(t)
+
+		m := HaveConditionTrue("missingCondition")
+		ok, err := m.Match(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ok).To(BeFalse())
+		g.Expect(m.FailureMessage(obj)).To(ContainSubstring("does not exist"))
+	})
+}